@@ -0,0 +1,33 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// openshiftCmd groups commands that operate on the OpenShift distribution
+// itself, as opposed to the Minishift VM around it.
+var openshiftCmd = &cobra.Command{
+	Use:   "openshift",
+	Short: "Commands for interacting with OpenShift",
+	Long:  "Commands for interacting with the OpenShift distribution running inside minishift.",
+}
+
+func init() {
+	RootCmd.AddCommand(openshiftCmd)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	ocversion "github.com/minishift/minishift/pkg/minishift/openshift/version"
+)
+
+// minOpenshiftSupportedVersion and defaultOpenshiftVersion bound the regular
+// (unconstrained) output of get-openshift-versions.
+const (
+	minOpenshiftSupportedVersion = "3.9.0"
+	defaultOpenshiftVersion      = "3.11.0"
+
+	configReleaseSourceURL = "release-source-url"
+)
+
+var (
+	versionsConstraint string
+	versionsChannel     string
+)
+
+// getOpenshiftVersionsCmd lists the OpenShift versions minishift can start.
+var getOpenshiftVersionsCmd = &cobra.Command{
+	Use:   "get-openshift-versions",
+	Short: "Gets the list of OpenShift versions available for minishift",
+	Long:  "Gets the list of OpenShift versions available for minishift. Use --constraint to narrow the list down to a specific version range, e.g. '~3.10' or '>=3.9.0, <3.11.0', and --channel to pick which release channel to query.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if versionsChannel == "" || versionsChannel == "stable" {
+			if err := ocversion.PrintUpStreamVersions(os.Stdout, minOpenshiftSupportedVersion, defaultOpenshiftVersion, versionsConstraint); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+
+		source, err := releaseSourceForChannel(versionsChannel)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		releases, err := source.List(context.Background())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		var tags []string
+		for _, release := range releases {
+			tags = append(tags, release.Name)
+		}
+		if versionsConstraint != "" {
+			tags, err = ocversion.FilterVersions(tags, versionsConstraint)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+
+		sort.Strings(tags)
+		fmt.Fprint(os.Stdout, "The following OpenShift versions are available: \n")
+		for _, tag := range tags {
+			fmt.Fprintf(os.Stdout, "\t- %s\n", tag)
+		}
+	},
+}
+
+// releaseSourceForChannel resolves the --channel flag to a concrete
+// ocversion.ReleaseSource.
+func releaseSourceForChannel(channel string) (ocversion.ReleaseSource, error) {
+	switch channel {
+	case "prerelease":
+		return ocversion.OpenshiftGithubReleaseSource, nil
+	case "nightly":
+		return ocversion.ReleaseControllerSource{BaseURL: "https://amd64.ocp.releases.ci.openshift.org", Stream: "4-stable"}, nil
+	case "downstream":
+		return ocversion.OpenshiftRedHatRegistrySource, nil
+	default:
+		if mirrorURL := viper.GetString(configReleaseSourceURL); mirrorURL != "" {
+			return ocversion.MirrorReleaseSource{URL: mirrorURL}, nil
+		}
+		return nil, fmt.Errorf("unknown --channel '%s' (expected one of: stable, prerelease, nightly, downstream)", channel)
+	}
+}
+
+func init() {
+	getOpenshiftVersionsCmd.Flags().StringVar(&versionsConstraint, "constraint", "", "Only list versions satisfying this hashicorp/go-version constraint, e.g. '~3.10' or '>=3.9.0, <3.11.0'")
+	getOpenshiftVersionsCmd.Flags().StringVar(&versionsChannel, "channel", "stable", "Release channel to query: stable, prerelease, nightly, downstream")
+	RootCmd.AddCommand(getOpenshiftVersionsCmd)
+}
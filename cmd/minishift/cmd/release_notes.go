@@ -0,0 +1,97 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/provision"
+	"github.com/spf13/cobra"
+
+	minishiftConstants "github.com/minishift/minishift/pkg/minishift/constants"
+	ocversion "github.com/minishift/minishift/pkg/minishift/openshift/version"
+	"github.com/minishift/minishift/pkg/util"
+)
+
+// sshCommander returns an SSH commander for the currently running Minishift
+// VM, the same way other commands (e.g. `minishift ssh`) reach the VM.
+func sshCommander() (provision.SSHCommander, error) {
+	client := libmachine.NewClient(minishiftConstants.Minipath, minishiftConstants.MakeMiniPath("certs"))
+	defer client.Close()
+
+	host, err := client.Load(minishiftConstants.MachineName)
+	if err != nil {
+		return nil, err
+	}
+	return provision.GenericSSHCommander{Driver: host.Driver}, nil
+}
+
+var (
+	releaseNotesSince bool
+	releaseNotesPlain bool
+)
+
+// releaseNotesCmd prints the release notes for a given OpenShift version, or
+// an upgrade summary covering every release since the running version.
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes [version]",
+	Short: "Prints the release notes for an OpenShift version",
+	Long:  "Prints the release notes for an OpenShift version, rendered from the GitHub release body. Use --since to print a combined summary covering every release between the currently running OpenShift version and the latest available one.",
+	Run: func(cmd *cobra.Command, args []string) {
+		var notes string
+		var err error
+
+		if releaseNotesSince {
+			commander, commanderErr := sshCommander()
+			if commanderErr != nil {
+				fmt.Fprintln(os.Stderr, commanderErr)
+				os.Exit(1)
+			}
+			currentVersion, currentErr := ocversion.GetOpenshiftVersionWithoutK8sAndEtcd(commander)
+			if currentErr != nil {
+				fmt.Fprintln(os.Stderr, currentErr)
+				os.Exit(1)
+			}
+			notes, err = ocversion.GetReleaseNotesSince(currentVersion)
+		} else {
+			if len(args) != 1 {
+				fmt.Fprintln(os.Stderr, "Usage: minishift openshift release-notes <version>")
+				os.Exit(1)
+			}
+			notes, err = ocversion.GetReleaseNotes(args[0])
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		if releaseNotesPlain {
+			fmt.Fprintln(os.Stdout, notes)
+		} else {
+			fmt.Fprint(os.Stdout, util.RenderMarkdownToANSI(notes))
+		}
+	},
+}
+
+func init() {
+	releaseNotesCmd.Flags().BoolVar(&releaseNotesSince, "since", false, "Print a combined summary of every release since the currently running OpenShift version")
+	releaseNotesCmd.Flags().BoolVar(&releaseNotesPlain, "plain", false, "Print the raw markdown instead of rendering it to ANSI")
+	openshiftCmd.AddCommand(releaseNotesCmd)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	ocversion "github.com/minishift/minishift/pkg/minishift/openshift/version"
+)
+
+// minishiftVersion is set at build time via -ldflags.
+var minishiftVersion = "v0.0.0-dev"
+
+var quiet bool
+
+// RootCmd represents the base command when called without any subcommands.
+var RootCmd = &cobra.Command{
+	Use:   "minishift",
+	Short: "Minishift is a tool for application development in local OpenShift clusters.",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		notifyOfAvailableUpdate()
+	},
+}
+
+func init() {
+	RootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress all non-essential output")
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+func Execute() {
+	if err := RootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// notifyOfAvailableUpdate prints a one-line notice when a newer Minishift
+// release is available. It is rate-limited via a timestamp cached in
+// MINISHIFT_HOME and is a no-op when disabled via --quiet or
+// MINISHIFT_UPDATE_NOTIFIER=false. Any error (network, cache, parsing) is
+// swallowed so the update check can never break a normal command.
+func notifyOfAvailableUpdate() {
+	if quiet {
+		return
+	}
+	if os.Getenv("MINISHIFT_UPDATE_NOTIFIER") == "false" {
+		return
+	}
+	if !shouldCheckForUpdate() {
+		return
+	}
+
+	newer, latestTag, err := ocversion.NewerVersionAvailable(minishiftVersion)
+	recordUpdateCheck()
+	if err != nil || !newer {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "A newer release of minishift (%s) is available\n", latestTag)
+}
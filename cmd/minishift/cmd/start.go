@@ -0,0 +1,42 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var openshiftVersionFlag string
+
+// startCmd starts the local OpenShift cluster. Provisioning of the VM itself
+// is handled by the wider start machinery; this file carries the
+// --openshift-version preflight checks.
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Starts a local OpenShift cluster",
+	Long:  "Starts a local single-node OpenShift cluster.",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return runStartPreflightChecks(openshiftVersionFlag)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+	},
+}
+
+func init() {
+	startCmd.Flags().StringVar(&openshiftVersionFlag, "openshift-version", defaultOpenshiftVersion, "The OpenShift version to start, e.g. 'v3.11.0'")
+	RootCmd.AddCommand(startCmd)
+}
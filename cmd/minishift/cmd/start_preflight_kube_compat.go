@@ -0,0 +1,59 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	ocversion "github.com/minishift/minishift/pkg/minishift/openshift/version"
+)
+
+// runStartPreflightChecks runs the `minishift start` preflight checks that
+// only need the requested --openshift-version, before the VM is provisioned.
+// It returns an error when --openshift-version is rejected outright;
+// anything less severe (e.g. the deprecated-API warning) is printed directly
+// instead of blocking startup.
+func runStartPreflightChecks(openshiftVersion string) error {
+	if err := validateOpenshiftVersion(openshiftVersion); err != nil {
+		return err
+	}
+	warnAboutDeprecatedAPIs(openshiftVersion)
+	return nil
+}
+
+// warnAboutDeprecatedAPIs is called from the `minishift start` preflight
+// checks once the requested --openshift-version is known. It is informational
+// only: it never blocks startup, since users may legitimately not use the
+// affected APIs.
+func warnAboutDeprecatedAPIs(openshiftVersion string) {
+	deprecated := ocversion.DeprecatedAPIsFor(openshiftVersion)
+	if len(deprecated) == 0 {
+		return
+	}
+
+	kubeVersion, err := ocversion.KubernetesVersionFor(openshiftVersion)
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Warning: OpenShift %s bundles Kubernetes %s, which deprecates or removes:\n", openshiftVersion, kubeVersion)
+	for _, api := range deprecated {
+		fmt.Fprintf(os.Stderr, "\t- %s\n", api)
+	}
+	fmt.Fprintln(os.Stderr, "Workloads relying on these APIs may fail to deploy.")
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	ocversion "github.com/minishift/minishift/pkg/minishift/openshift/version"
+)
+
+// minSupportedOpenshiftVersionConstraint is derived from
+// minOpenshiftSupportedVersion (defined alongside get-openshift-versions) so
+// the minimum supported version has a single source of truth rather than
+// being duplicated as a second literal here.
+func minSupportedOpenshiftVersionConstraint() string {
+	return fmt.Sprintf(">=%s", minOpenshiftSupportedVersion)
+}
+
+// validateOpenshiftVersion ensures --openshift-version satisfies the
+// minimum-supported constraint before `minishift start` attempts to
+// provision a VM with it.
+func validateOpenshiftVersion(openshiftVersion string) error {
+	constraint := minSupportedOpenshiftVersionConstraint()
+	satisfied, err := ocversion.FilterVersions([]string{openshiftVersion}, constraint)
+	if err != nil {
+		return err
+	}
+	if len(satisfied) == 0 {
+		return fmt.Errorf("OpenShift version %s does not satisfy the minimum supported constraint %s", openshiftVersion, constraint)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	minishiftConstants "github.com/minishift/minishift/pkg/minishift/constants"
+)
+
+// updateCheckInterval is how often the update notifier is allowed to hit the
+// GitHub releases API. It is intentionally coarse so that minishift does not
+// generate network traffic on every single invocation.
+const updateCheckInterval = 24 * time.Hour
+
+// updateCheckFile is a var rather than a plain function so tests can point it
+// at a temporary directory instead of the real MINISHIFT_HOME.
+var updateCheckFile = func() string {
+	return filepath.Join(minishiftConstants.Minipath, "update_check")
+}
+
+// shouldCheckForUpdate returns true when enough time has passed since the
+// last recorded check (or none was ever recorded). Any failure to read the
+// cache is treated as "go ahead and check" rather than an error.
+func shouldCheckForUpdate() bool {
+	content, err := ioutil.ReadFile(updateCheckFile())
+	if err != nil {
+		return true
+	}
+
+	lastCheckUnix, err := strconv.ParseInt(string(content), 10, 64)
+	if err != nil {
+		return true
+	}
+
+	return time.Since(time.Unix(lastCheckUnix, 0)) >= updateCheckInterval
+}
+
+// recordUpdateCheck persists the current time so the next invocation knows
+// not to check again until updateCheckInterval has elapsed. Failures to
+// create the cache directory or write are ignored, since the worst outcome
+// is an extra network call on the next invocation.
+func recordUpdateCheck() {
+	_ = os.MkdirAll(filepath.Dir(updateCheckFile()), 0755)
+	_ = ioutil.WriteFile(updateCheckFile(), []byte(strconv.FormatInt(time.Now().Unix(), 10)), 0644)
+}
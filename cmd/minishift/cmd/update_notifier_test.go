@@ -0,0 +1,86 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func withTempUpdateCheckFile(t *testing.T) func() {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "minishift-update-check")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+
+	original := updateCheckFile
+	updateCheckFile = func() string {
+		return filepath.Join(dir, "minishift-home-not-created-yet", "update_check")
+	}
+
+	return func() {
+		updateCheckFile = original
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRecordUpdateCheckCreatesMissingHomeDir(t *testing.T) {
+	restore := withTempUpdateCheckFile(t)
+	defer restore()
+
+	if !shouldCheckForUpdate() {
+		t.Fatal("shouldCheckForUpdate() = false before any check was ever recorded, expected true")
+	}
+
+	recordUpdateCheck()
+
+	if _, err := os.Stat(updateCheckFile()); err != nil {
+		t.Fatalf("recordUpdateCheck() did not create %s: %s", updateCheckFile(), err)
+	}
+
+	if shouldCheckForUpdate() {
+		t.Error("shouldCheckForUpdate() = true immediately after recordUpdateCheck(), expected false")
+	}
+}
+
+func TestShouldCheckForUpdateHonorsInterval(t *testing.T) {
+	restore := withTempUpdateCheckFile(t)
+	defer restore()
+
+	recordUpdateCheck()
+	if shouldCheckForUpdate() {
+		t.Error("shouldCheckForUpdate() = true right after a check, expected false")
+	}
+
+	stale := time.Now().Add(-(updateCheckInterval + time.Hour)).Unix()
+	if err := os.MkdirAll(filepath.Dir(updateCheckFile()), 0755); err != nil {
+		t.Fatalf("failed to create cache dir: %s", err)
+	}
+	if err := ioutil.WriteFile(updateCheckFile(), []byte(strconv.FormatInt(stale, 10)), 0644); err != nil {
+		t.Fatalf("failed to write stale check file: %s", err)
+	}
+
+	if !shouldCheckForUpdate() {
+		t.Error("shouldCheckForUpdate() = false for a check older than updateCheckInterval, expected true")
+	}
+}
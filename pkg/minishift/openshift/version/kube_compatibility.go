@@ -0,0 +1,103 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ocpToKubeVersion maps an OpenShift minor version to the Kubernetes minor
+// version it bundles. Update this table as new OpenShift releases ship.
+var ocpToKubeVersion = map[string]string{
+	"3.9":  "1.9",
+	"3.10": "1.10",
+	"3.11": "1.11",
+	"4.1":  "1.13",
+	"4.2":  "1.14",
+	"4.3":  "1.16",
+	"4.4":  "1.17",
+	"4.5":  "1.18",
+	"4.6":  "1.19",
+	"4.7":  "1.20",
+	"4.8":  "1.21",
+	"4.9":  "1.22",
+	"4.10": "1.23",
+	"4.11": "1.24",
+}
+
+// ocpVerV1beta1Unsupported lists the Kubernetes APIs known to be
+// deprecated or removed in the Kubernetes release bundled with the given
+// OpenShift version. Workloads relying on these APIs will fail to deploy
+// once the user upgrades to that OpenShift version.
+var ocpVerV1beta1Unsupported = map[string][]string{
+	"4.9":  {"apiextensions.k8s.io/v1beta1 CustomResourceDefinition", "admissionregistration.k8s.io/v1beta1"},
+	"4.10": {"apiextensions.k8s.io/v1beta1 CustomResourceDefinition", "admissionregistration.k8s.io/v1beta1", "authentication.k8s.io/v1beta1 TokenReview"},
+	"4.11": {"batch/v1beta1 CronJob", "discovery.k8s.io/v1beta1 EndpointSlice", "events.k8s.io/v1beta1 Event"},
+}
+
+// KubernetesVersionFor returns the Kubernetes minor version bundled with the
+// given OpenShift version, e.g. "4.11" -> "1.24".
+func KubernetesVersionFor(openshiftVersion string) (string, error) {
+	minor, err := ocpMinor(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+
+	kubeVersion, ok := ocpToKubeVersion[minor]
+	if !ok {
+		return "", fmt.Errorf("no known Kubernetes version for OpenShift %s", openshiftVersion)
+	}
+	return kubeVersion, nil
+}
+
+// DeprecatedAPIsFor returns the Kubernetes APIs known to be deprecated or
+// removed in the Kubernetes release bundled with the given OpenShift
+// version. An empty slice is returned when nothing is known to be affected.
+func DeprecatedAPIsFor(openshiftVersion string) []string {
+	minor, err := ocpMinor(openshiftVersion)
+	if err != nil {
+		return nil
+	}
+	return ocpVerV1beta1Unsupported[minor]
+}
+
+// ocpMinor trims an OpenShift version like "4.11.2" down to its "4.11" minor.
+func ocpMinor(openshiftVersion string) (string, error) {
+	major, minor, _, err := splitVersion(openshiftVersion)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s", major, minor), nil
+}
+
+// splitVersion splits a version string like "4.11.2" into its major, minor
+// and patch components. The patch component may be empty for versions like
+// "4.11".
+func splitVersion(v string) (major string, minor string, patch string, err error) {
+	parts := strings.Split(strings.TrimPrefix(v, "v"), ".")
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("invalid version format '%s'", v)
+	}
+
+	major = parts[0]
+	minor = parts[1]
+	if len(parts) > 2 {
+		patch = parts[2]
+	}
+	return major, minor, patch, nil
+}
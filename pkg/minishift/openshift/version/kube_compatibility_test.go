@@ -0,0 +1,70 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import "testing"
+
+func TestKubernetesVersionFor(t *testing.T) {
+	var tests = []struct {
+		openshiftVersion string
+		expectedKube     string
+		expectError      bool
+	}{
+		{openshiftVersion: "3.11.0", expectedKube: "1.11"},
+		{openshiftVersion: "4.10.3", expectedKube: "1.23"},
+		{openshiftVersion: "4.11", expectedKube: "1.24"},
+		{openshiftVersion: "9.99.0", expectError: true},
+	}
+
+	for _, test := range tests {
+		kubeVersion, err := KubernetesVersionFor(test.openshiftVersion)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("KubernetesVersionFor(%s): expected an error, got none", test.openshiftVersion)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("KubernetesVersionFor(%s): unexpected error: %s", test.openshiftVersion, err)
+			continue
+		}
+		if kubeVersion != test.expectedKube {
+			t.Errorf("KubernetesVersionFor(%s) = %s, expected %s", test.openshiftVersion, kubeVersion, test.expectedKube)
+		}
+	}
+}
+
+func TestDeprecatedAPIsFor(t *testing.T) {
+	var tests = []struct {
+		openshiftVersion string
+		expectEmpty      bool
+	}{
+		{openshiftVersion: "4.11.1", expectEmpty: false},
+		{openshiftVersion: "3.11.0", expectEmpty: true},
+		{openshiftVersion: "9.99.0", expectEmpty: true},
+	}
+
+	for _, test := range tests {
+		deprecated := DeprecatedAPIsFor(test.openshiftVersion)
+		if test.expectEmpty && len(deprecated) != 0 {
+			t.Errorf("DeprecatedAPIsFor(%s): expected no deprecated APIs, got %v", test.openshiftVersion, deprecated)
+		}
+		if !test.expectEmpty && len(deprecated) == 0 {
+			t.Errorf("DeprecatedAPIsFor(%s): expected deprecated APIs, got none", test.openshiftVersion)
+		}
+	}
+}
@@ -17,28 +17,22 @@ limitations under the License.
 package version
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"regexp"
 	"sort"
 	"strings"
 
-	"github.com/blang/semver"
 	"github.com/docker/machine/libmachine/provision"
+	hashiversion "github.com/hashicorp/go-version"
 	"github.com/minishift/minishift/pkg/minikube/constants"
 	minikubeConstants "github.com/minishift/minishift/pkg/minikube/constants"
 	minishiftConstants "github.com/minishift/minishift/pkg/minishift/constants"
 	"github.com/minishift/minishift/pkg/minishift/docker"
-	"github.com/minishift/minishift/pkg/util"
 )
 
-type releaseTags struct {
-	Name string `json:"name"`
-}
-
 func GetOpenshiftVersion(sshCommander provision.SSHCommander) (string, error) {
 	dockerCommander := docker.NewVmDockerCommander(sshCommander)
 	return dockerCommander.Exec(" ", minishiftConstants.OpenshiftContainerName, "openshift", "version")
@@ -62,29 +56,25 @@ func GetOpenshiftVersionWithoutK8sAndEtcd(sshCommander provision.SSHCommander) (
 	return openShiftVersion, nil
 }
 
+// PrintDownStreamVersions prints the Red Hat registry tags for the downstream
+// (supported, OSE) OpenShift distribution.
 func PrintDownStreamVersions(output io.Writer, minSupportedVersion string) error {
-	resp, err := getResponseBody("https://registry.access.redhat.com/v1/repositories/openshift3/ose/tags")
+	releases, err := OpenshiftRedHatRegistrySource.List(context.Background())
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	decoder := json.NewDecoder(resp.Body)
-	var data map[string]string
-	err = decoder.Decode(&data)
-	if err != nil {
-		return errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
-	}
+
 	fmt.Fprint(output, "The following OpenShift versions are available: \n")
 	var tagsList []string
-	for version := range data {
-		if util.VersionOrdinal(version) >= util.VersionOrdinal(minSupportedVersion) {
-			if strings.Contains(version, "latest") {
-				continue
-			}
-			if strings.Contains(version, "-") {
-				continue
-			}
-			tagsList = append(tagsList, version)
+	for _, release := range releases {
+		if strings.Contains(release.Name, "latest") {
+			continue
+		}
+		if strings.Contains(release.Name, "-") {
+			continue
+		}
+		if valid, _ := IsGreaterOrEqualToBaseVersion(release.Name, minSupportedVersion); valid {
+			tagsList = append(tagsList, release.Name)
 		}
 	}
 	sort.Strings(tagsList)
@@ -97,26 +87,36 @@ func PrintDownStreamVersions(output io.Writer, minSupportedVersion string) error
 // PrintUpStreamVersions prints the origin versions which satisfies the following conditions:
 // 	1. Major versions greater than or equal to the minimum supported and default version
 //	2. Pre-release versions greater than default version
-func PrintUpStreamVersions(output io.Writer, minSupportedVersion string, defaultVersion string) error {
+// When constraintString is non-empty, the result is additionally narrowed down
+// to versions satisfying that hashicorp/go-version constraint, e.g. "~3.10".
+func PrintUpStreamVersions(output io.Writer, minSupportedVersion string, defaultVersion string, constraintString string) error {
 	var releaseList []string
-	data, err := getGithubReleases()
+	releases, err := OpenshiftGithubReleaseSource.List(context.Background())
 	if err != nil {
 		return err
 	}
-	for _, releaseTag := range data {
-		if strings.Contains(releaseTag.Name, "latest") {
+	for _, release := range releases {
+		if strings.Contains(release.Name, "latest") {
 			continue
 		}
-		if valid, _ := IsGreaterOrEqualToBaseVersion(releaseTag.Name, minSupportedVersion); valid {
-			if valid, _ := IsGreaterOrEqualToBaseVersion(releaseTag.Name, defaultVersion); valid {
-				releaseList = append(releaseList, releaseTag.Name)
+		if valid, _ := IsGreaterOrEqualToBaseVersion(release.Name, minSupportedVersion); valid {
+			if valid, _ := IsGreaterOrEqualToBaseVersion(release.Name, defaultVersion); valid {
+				releaseList = append(releaseList, release.Name)
 			} else {
-				if !isPrerelease(releaseTag.Name) {
-					releaseList = append(releaseList, releaseTag.Name)
+				if !isPrerelease(release.Name) {
+					releaseList = append(releaseList, release.Name)
 				}
 			}
 		}
 	}
+
+	if constraintString != "" {
+		releaseList, err = FilterVersions(releaseList, constraintString)
+		if err != nil {
+			return err
+		}
+	}
+
 	sort.Strings(releaseList)
 	fmt.Fprint(output, "The following OpenShift versions are available: \n")
 	for _, tag := range releaseList {
@@ -125,14 +125,6 @@ func PrintUpStreamVersions(output io.Writer, minSupportedVersion string, default
 	return nil
 }
 
-func getResponseBody(url string) (resp *http.Response, err error) {
-	resp, err = http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
-}
-
 func isPrerelease(tag string) bool {
 	if match, _ := regexp.MatchString("alpha|beta|rc", tag); match {
 		return true
@@ -142,36 +134,56 @@ func isPrerelease(tag string) bool {
 
 // IsGreaterOrEqualToBaseVersion returns true if the version is greater or equal to the base version
 func IsGreaterOrEqualToBaseVersion(version string, baseVersion string) (bool, error) {
-	v, err := semver.Parse(strings.TrimPrefix(version, constants.VersionPrefix))
+	v, err := hashiversion.NewVersion(strings.TrimPrefix(version, constants.VersionPrefix))
 	if err != nil {
 		return false, errors.New(fmt.Sprintf("Invalid version format '%s': %s", version, err.Error()))
 	}
 
 	baseVersionToCompare := strings.TrimPrefix(baseVersion, constants.VersionPrefix)
-	versionRange, err := semver.ParseRange(fmt.Sprintf(">=%s", baseVersionToCompare))
+	constraint, err := hashiversion.NewConstraint(fmt.Sprintf(">=%s", baseVersionToCompare))
 	if err != nil {
 		fmt.Println("Not able to parse version info", err)
 		return false, err
 	}
 
-	if versionRange(v) {
-		return true, nil
-	}
-	return false, nil
+	return constraint.Check(v), nil
 }
 
-func getGithubReleases() ([]releaseTags, error) {
-	githubReleaseUrl := "https://api.github.com/repos/openshift/origin/releases"
-	resp, err := getResponseBody(githubReleaseUrl)
-	if err != nil {
-		return nil, err
+// FilterVersions returns the subset of tags which satisfy the given
+// hashicorp/go-version constraint string, e.g. ">=3.9.0, <3.11.0" or "~3.10".
+// A bare "~X.Y" (which hashicorp/go-version does not parse itself) is
+// rewritten to "~>X.Y.0", so it matches only the X.Y.z patch releases.
+// Tags which do not parse as a valid version are silently skipped, mirroring
+// the existing tag-filtering behaviour of PrintDownStreamVersions and
+// PrintUpStreamVersions.
+func FilterVersions(tags []string, constraintString string) ([]string, error) {
+	parts := strings.Split(constraintString, ",")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "~") && !strings.HasPrefix(part, "~>") {
+			bound := strings.TrimSpace(strings.TrimPrefix(part, "~"))
+			if strings.Count(bound, ".") < 2 {
+				bound += ".0"
+			}
+			part = "~>" + bound
+		}
+		parts[i] = part
 	}
-	defer resp.Body.Close()
-	decoder := json.NewDecoder(resp.Body)
-	var data []releaseTags
-	err = decoder.Decode(&data)
+
+	constraint, err := hashiversion.NewConstraint(strings.Join(parts, ","))
 	if err != nil {
-		return nil, errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
+		return nil, errors.New(fmt.Sprintf("Invalid constraint '%s': %s", constraintString, err.Error()))
+	}
+
+	var filtered []string
+	for _, tag := range tags {
+		v, err := hashiversion.NewVersion(strings.TrimPrefix(tag, constants.VersionPrefix))
+		if err != nil {
+			continue
+		}
+		if constraint.Check(v) {
+			filtered = append(filtered, tag)
+		}
 	}
-	return data, nil
+	return filtered, nil
 }
@@ -0,0 +1,66 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterVersions(t *testing.T) {
+	tags := []string{"v3.9.0", "v3.10.0", "v3.10.1", "v3.11.0", "latest"}
+
+	var tests = []struct {
+		description string
+		constraint  string
+		expected    []string
+		expectError bool
+	}{
+		{
+			description: "range constraint",
+			constraint:  ">=3.9.0, <3.11.0",
+			expected:    []string{"v3.9.0", "v3.10.0", "v3.10.1"},
+		},
+		{
+			description: "tilde constraint narrows to a minor",
+			constraint:  "~3.10",
+			expected:    []string{"v3.10.0", "v3.10.1"},
+		},
+		{
+			description: "invalid constraint errors out",
+			constraint:  "not-a-constraint",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		filtered, err := FilterVersions(tags, test.constraint)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got none", test.description)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.description, err)
+			continue
+		}
+		if !reflect.DeepEqual(filtered, test.expected) {
+			t.Errorf("%s: FilterVersions() = %v, expected %v", test.description, filtered, test.expected)
+		}
+	}
+}
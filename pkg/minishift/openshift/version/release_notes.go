@@ -0,0 +1,126 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	hashiversion "github.com/hashicorp/go-version"
+)
+
+// GetReleaseNotes returns the markdown release notes body for a single
+// OpenShift release tag, as published in its GitHub release.
+func GetReleaseNotes(version string) (string, error) {
+	releases, err := OpenshiftGithubReleaseSource.List(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	for _, release := range releases {
+		if release.Name == version {
+			return release.Body, nil
+		}
+	}
+	return "", fmt.Errorf("no release notes found for OpenShift version '%s'", version)
+}
+
+// GetReleaseNotesSince concatenates the release notes for every OpenShift
+// release strictly newer than currentVersion, ordered from oldest to newest,
+// into a single upgrade summary.
+func GetReleaseNotesSince(currentVersion string) (string, error) {
+	releases, err := OpenshiftGithubReleaseSource.List(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	newer, err := filterNewerReleases(releases, currentVersion)
+	if err != nil {
+		return "", err
+	}
+
+	if len(newer) == 0 {
+		return "", fmt.Errorf("no OpenShift releases found newer than '%s'", currentVersion)
+	}
+
+	var summary strings.Builder
+	for _, release := range newer {
+		fmt.Fprintf(&summary, "## %s\n\n%s\n\n", release.Name, release.Body)
+	}
+	return summary.String(), nil
+}
+
+// filterNewerReleases returns the releases strictly newer than currentVersion,
+// sorted oldest to newest. Both sides are parsed as real versions before
+// comparison, rather than compared as strings, so that the currently running
+// release (whose tag keeps its "v" prefix, unlike currentVersion) is
+// correctly recognised as equal to itself instead of leaking into the
+// summary.
+func filterNewerReleases(releases []Release, currentVersion string) ([]Release, error) {
+	current, err := hashiversion.NewVersion(strings.TrimPrefix(currentVersion, "v"))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid version format '%s': %s", currentVersion, err.Error()))
+	}
+
+	var newer []Release
+	for _, release := range releases {
+		if strings.Contains(release.Name, "latest") {
+			continue
+		}
+		v, err := hashiversion.NewVersion(strings.TrimPrefix(release.Name, "v"))
+		if err != nil {
+			continue
+		}
+		if v.GreaterThan(current) {
+			newer = append(newer, release)
+		}
+	}
+
+	// Sort oldest to newest using real version comparison (via
+	// hashiversion.Collection, as the rest of the package does) rather than a
+	// lexicographic string compare, which would put e.g. "3.10.0" before
+	// "3.9.0".
+	return sortReleasesByVersion(newer), nil
+}
+
+// sortReleasesByVersion returns releases sorted oldest to newest, parsing
+// each Name as a real version rather than comparing it lexicographically.
+// Releases whose Name does not parse as a version are dropped, mirroring how
+// FilterVersions skips unparseable tags elsewhere in this package.
+func sortReleasesByVersion(releases []Release) []Release {
+	versions := make(hashiversion.Collection, 0, len(releases))
+	byVersion := make(map[string]Release, len(releases))
+	for _, release := range releases {
+		v, err := hashiversion.NewVersion(strings.TrimPrefix(release.Name, "v"))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+		byVersion[v.String()] = release
+	}
+
+	sort.Sort(versions)
+
+	sorted := make([]Release, 0, len(versions))
+	for _, v := range versions {
+		sorted = append(sorted, byVersion[v.String()])
+	}
+	return sorted
+}
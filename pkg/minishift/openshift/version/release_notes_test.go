@@ -0,0 +1,72 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import "testing"
+
+func TestSortReleasesByVersion(t *testing.T) {
+	releases := []Release{
+		{Name: "v3.11.0"},
+		{Name: "v3.9.0"},
+		{Name: "v3.10.0"},
+	}
+
+	sorted := sortReleasesByVersion(releases)
+
+	expected := []string{"v3.9.0", "v3.10.0", "v3.11.0"}
+	if len(sorted) != len(expected) {
+		t.Fatalf("sortReleasesByVersion() returned %d releases, expected %d", len(sorted), len(expected))
+	}
+	for i, release := range sorted {
+		if release.Name != expected[i] {
+			t.Errorf("sortReleasesByVersion()[%d] = %s, expected %s (lexicographic sort would put 3.10.0/3.11.0 before 3.9.0)", i, release.Name, expected[i])
+		}
+	}
+}
+
+func TestSortReleasesByVersionDropsUnparseableTags(t *testing.T) {
+	releases := []Release{{Name: "v3.9.0"}, {Name: "latest"}}
+
+	sorted := sortReleasesByVersion(releases)
+
+	if len(sorted) != 1 || sorted[0].Name != "v3.9.0" {
+		t.Errorf("sortReleasesByVersion() = %v, expected only v3.9.0 to survive", sorted)
+	}
+}
+
+func TestFilterNewerReleasesExcludesCurrentVersion(t *testing.T) {
+	releases := []Release{
+		{Name: "v3.9.0", Body: "old"},
+		{Name: "v3.9.1", Body: "current"},
+		{Name: "v3.10.0", Body: "newer"},
+	}
+
+	newer, err := filterNewerReleases(releases, "3.9.1")
+	if err != nil {
+		t.Fatalf("filterNewerReleases() returned error: %s", err)
+	}
+
+	if len(newer) != 1 || newer[0].Name != "v3.10.0" {
+		t.Errorf("filterNewerReleases() = %v, expected only v3.10.0 (the currently running v3.9.1 must not leak into its own --since summary)", newer)
+	}
+}
+
+func TestFilterNewerReleasesInvalidCurrentVersion(t *testing.T) {
+	if _, err := filterNewerReleases(nil, "not-a-version"); err == nil {
+		t.Error("filterNewerReleases() with an unparseable currentVersion = nil error, expected an error")
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	minishiftConstants "github.com/minishift/minishift/pkg/minishift/constants"
+)
+
+// Release is a single OpenShift (or Minishift) release as reported by a
+// ReleaseSource, regardless of which upstream actually hosts it.
+type Release struct {
+	Name        string `json:"name"`
+	Body        string `json:"body"`
+	HtmlURL     string `json:"html_url"`
+	PublishedAt string `json:"published_at"`
+	Prerelease  bool   `json:"prerelease"`
+}
+
+// ReleaseSource is anything that can list the OpenShift releases available
+// from some channel: GitHub, a registry mirror, a release controller, or a
+// user-configured HTTP mirror.
+type ReleaseSource interface {
+	List(ctx context.Context) ([]Release, error)
+}
+
+// releaseCacheDir is where HTTP responses from release sources are cached on
+// disk, keyed by ETag, so that repeated invocations of get-openshift-versions
+// do not re-download the same payload. It is a var rather than a plain
+// function so tests can point it at a temporary directory instead of the real
+// MINISHIFT_HOME.
+var releaseCacheDir = func() string {
+	return filepath.Join(minishiftConstants.Minipath, "cache", "releases")
+}
+
+// httpClient returns an *http.Client configured to honor HTTPS_PROXY/NO_PROXY
+// the same way the standard library does by default via
+// http.ProxyFromEnvironment, used explicitly here so every ReleaseSource
+// shares one consistently configured transport.
+func httpClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}
+}
+
+// cachedGet performs an HTTP GET against url, using a previously cached ETag
+// (if any) to issue a conditional request. On a 304 response the cached body
+// is returned; otherwise the fresh body is cached (together with its ETag)
+// and returned. Any cache read/write failure is ignored and simply results in
+// an uncached request, since the cache is purely a performance optimization.
+func cachedGet(ctx context.Context, rawURL string) ([]byte, error) {
+	cacheKey := cacheKeyFor(rawURL)
+	cacheFile := filepath.Join(releaseCacheDir(), cacheKey+".json")
+	etagFile := filepath.Join(releaseCacheDir(), cacheKey+".etag")
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if etag, err := ioutil.ReadFile(etagFile); err == nil {
+		if _, err := os.Stat(cacheFile); err == nil {
+			req.Header.Set("If-None-Match", string(etag))
+		}
+	}
+
+	resp, err := httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		if body, err := ioutil.ReadFile(cacheFile); err == nil {
+			return body, nil
+		}
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d fetching %s", resp.StatusCode, rawURL)
+	}
+
+	if err := os.MkdirAll(releaseCacheDir(), 0755); err == nil {
+		_ = ioutil.WriteFile(cacheFile, body, 0644)
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			_ = ioutil.WriteFile(etagFile, []byte(etag), 0644)
+		}
+	}
+
+	return body, nil
+}
+
+func cacheKeyFor(rawURL string) string {
+	replacer := strings.NewReplacer("://", "_", "/", "_", "?", "_", "&", "_")
+	return replacer.Replace(rawURL)
+}
+
+// githubAPIBaseURL is the default GitHub API endpoint used by
+// GithubReleaseSource when BaseURL is left empty.
+const githubAPIBaseURL = "https://api.github.com"
+
+// GithubReleaseSource lists releases from a GitHub repository's releases API,
+// paginating through all pages rather than silently truncating at the
+// default page size.
+type GithubReleaseSource struct {
+	// Owner/Repo identify the GitHub repository, e.g. "openshift"/"origin".
+	Owner string
+	Repo  string
+
+	// BaseURL overrides githubAPIBaseURL, used by tests to point at an
+	// httptest.Server instead of the real GitHub API.
+	BaseURL string
+}
+
+// OpenshiftGithubReleaseSource, MinishiftGithubReleaseSource and
+// OpenshiftRedHatRegistrySource are the default ReleaseSource instances used
+// throughout the package and the CLI, so the repository/owner/registry
+// literals they wrap are defined in exactly one place.
+var (
+	OpenshiftGithubReleaseSource  = GithubReleaseSource{Owner: "openshift", Repo: "origin"}
+	MinishiftGithubReleaseSource  = GithubReleaseSource{Owner: "minishift", Repo: "minishift"}
+	OpenshiftRedHatRegistrySource = RedHatRegistrySource{Repository: "openshift3/ose"}
+)
+
+func (s GithubReleaseSource) List(ctx context.Context) ([]Release, error) {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = githubAPIBaseURL
+	}
+
+	var all []Release
+	page := 1
+	for {
+		pageURL := fmt.Sprintf("%s/repos/%s/%s/releases?per_page=100&page=%d", baseURL, s.Owner, s.Repo, page)
+		body, err := cachedGet(ctx, pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		var releases []Release
+		if err := json.Unmarshal(body, &releases); err != nil {
+			return nil, errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
+		}
+		if len(releases) == 0 {
+			break
+		}
+
+		all = append(all, releases...)
+		if len(releases) < 100 {
+			break
+		}
+		page++
+	}
+	return all, nil
+}
+
+// RedHatRegistrySource lists tags from a Red Hat v1 container registry
+// repository, e.g. "openshift3/ose". The registry API only returns tag
+// names, so every Release it produces has just its Name populated.
+type RedHatRegistrySource struct {
+	Repository string
+}
+
+func (s RedHatRegistrySource) List(ctx context.Context) ([]Release, error) {
+	tagsURL := fmt.Sprintf("https://registry.access.redhat.com/v1/repositories/%s/tags", s.Repository)
+	body, err := cachedGet(ctx, tagsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]string
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
+	}
+
+	var releases []Release
+	for tag := range data {
+		releases = append(releases, Release{Name: tag})
+	}
+	return releases, nil
+}
+
+// ReleaseControllerSource lists nightly/CI builds from an OpenShift
+// release-controller instance, e.g. https://amd64.ocp.releases.ci.openshift.org.
+type ReleaseControllerSource struct {
+	BaseURL string
+	Stream  string
+}
+
+type releaseControllerResponse struct {
+	Tags []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+}
+
+func (s ReleaseControllerSource) List(ctx context.Context) ([]Release, error) {
+	tagsURL := fmt.Sprintf("%s/api/v1/releasestream/%s/tags", strings.TrimSuffix(s.BaseURL, "/"), url.PathEscape(s.Stream))
+	body, err := cachedGet(ctx, tagsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var data releaseControllerResponse
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
+	}
+
+	var releases []Release
+	for _, tag := range data.Tags {
+		releases = append(releases, Release{Name: tag.Name, Prerelease: true})
+	}
+	return releases, nil
+}
+
+// MirrorReleaseSource lists releases from a generic HTTP mirror that serves
+// the same JSON array shape as the GitHub releases API. Its URL is
+// user-configured via `minishift config set release-source-url`.
+type MirrorReleaseSource struct {
+	URL string
+}
+
+func (s MirrorReleaseSource) List(ctx context.Context) ([]Release, error) {
+	body, err := cachedGet(ctx, s.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []Release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, errors.New(fmt.Sprintf("%T\n%s\n%#v\n", err, err, err))
+	}
+	return releases, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func withTempReleaseCacheDir(t *testing.T) func() {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "minishift-release-cache")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+
+	original := releaseCacheDir
+	releaseCacheDir = func() string {
+		return dir
+	}
+
+	return func() {
+		releaseCacheDir = original
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGithubReleaseSourceListStopsAtShortPage(t *testing.T) {
+	restore := withTempReleaseCacheDir(t)
+	defer restore()
+
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		switch page {
+		case "1":
+			fmt.Fprint(w, fullPageOfReleases(100))
+		case "2":
+			fmt.Fprint(w, fullPageOfReleases(1))
+		default:
+			t.Fatalf("unexpected request for page %s, List should have stopped after the short page", page)
+		}
+	}))
+	defer server.Close()
+
+	source := GithubReleaseSource{Owner: "openshift", Repo: "origin", BaseURL: server.URL}
+	releases, err := source.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() returned error: %s", err)
+	}
+
+	if len(releases) != 101 {
+		t.Errorf("List() returned %d releases, expected 101 (100 from page 1 + 1 from page 2)", len(releases))
+	}
+	if len(requestedPages) != 2 {
+		t.Errorf("List() made %d requests, expected exactly 2 (it should stop once a page comes back short)", len(requestedPages))
+	}
+}
+
+func TestGithubReleaseSourceListStopsAtEmptyPage(t *testing.T) {
+	restore := withTempReleaseCacheDir(t)
+	defer restore()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, "[]")
+	}))
+	defer server.Close()
+
+	source := GithubReleaseSource{Owner: "openshift", Repo: "origin", BaseURL: server.URL}
+	releases, err := source.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() returned error: %s", err)
+	}
+
+	if len(releases) != 0 {
+		t.Errorf("List() returned %d releases, expected 0", len(releases))
+	}
+	if requests != 1 {
+		t.Errorf("List() made %d requests, expected exactly 1 for an immediately-empty first page", requests)
+	}
+}
+
+func fullPageOfReleases(count int) string {
+	releases := make([]Release, count)
+	for i := range releases {
+		releases[i] = Release{Name: "v3.9." + strconv.Itoa(i)}
+	}
+	body, _ := json.Marshal(releases)
+	return string(body)
+}
+
+func TestCachedGetReusesBodyOn304(t *testing.T) {
+	restore := withTempReleaseCacheDir(t)
+	defer restore()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"the-etag"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"the-etag"`)
+		fmt.Fprint(w, `{"hello":"world"}`)
+	}))
+	defer server.Close()
+
+	first, err := cachedGet(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("cachedGet() first request returned error: %s", err)
+	}
+
+	second, err := cachedGet(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("cachedGet() second request returned error: %s", err)
+	}
+
+	if string(second) != string(first) {
+		t.Errorf("cachedGet() second call = %q, expected the cached body %q reused on a 304", second, first)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, expected exactly 2 (the cached ETag turns the second call into a conditional request, not a skipped one)", requests)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+// NewerVersionAvailable queries the Minishift GitHub releases feed and reports
+// whether a stable release newer than currentVersion exists. Prereleases are
+// ignored, since users should not be nagged into upgrading to an alpha/beta/rc.
+// Network or parsing errors are returned to the caller so they can decide
+// whether to surface or swallow them; the update notifier itself swallows them.
+func NewerVersionAvailable(currentVersion string) (bool, string, error) {
+	releases, err := MinishiftGithubReleaseSource.List(context.Background())
+	if err != nil {
+		return false, "", err
+	}
+
+	current, err := semver.Parse(strings.TrimPrefix(currentVersion, "v"))
+	if err != nil {
+		return false, "", err
+	}
+
+	latest, latestTag, found := latestStableRelease(releases)
+	if !found {
+		return false, "", nil
+	}
+
+	return latest.GT(current), latestTag, nil
+}
+
+// latestStableRelease returns the highest non-prerelease version among
+// releases, along with its original tag name. It is pure (no network access)
+// so it can be unit tested independently of NewerVersionAvailable.
+func latestStableRelease(releases []Release) (semver.Version, string, bool) {
+	var latest semver.Version
+	var latestTag string
+	for _, release := range releases {
+		if isPrerelease(release.Name) {
+			continue
+		}
+
+		candidate, err := semver.Parse(strings.TrimPrefix(release.Name, "v"))
+		if err != nil {
+			continue
+		}
+
+		if latestTag == "" || candidate.GT(latest) {
+			latest = candidate
+			latestTag = release.Name
+		}
+	}
+
+	return latest, latestTag, latestTag != ""
+}
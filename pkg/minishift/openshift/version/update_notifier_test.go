@@ -0,0 +1,68 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import "testing"
+
+func TestLatestStableRelease(t *testing.T) {
+	var tests = []struct {
+		description string
+		releases    []Release
+		expectedTag string
+		expectFound bool
+	}{
+		{
+			description: "picks the highest stable tag",
+			releases:    []Release{{Name: "v1.2.0"}, {Name: "v1.10.0"}, {Name: "v1.9.0"}},
+			expectedTag: "v1.10.0",
+			expectFound: true,
+		},
+		{
+			description: "ignores prereleases",
+			releases:    []Release{{Name: "v1.9.0"}, {Name: "v1.10.0-rc.1"}},
+			expectedTag: "v1.9.0",
+			expectFound: true,
+		},
+		{
+			description: "ignores tags that do not parse as a version",
+			releases:    []Release{{Name: "latest"}, {Name: "v1.2.0"}},
+			expectedTag: "v1.2.0",
+			expectFound: true,
+		},
+		{
+			description: "no stable releases at all",
+			releases:    []Release{{Name: "v1.0.0-alpha.1"}},
+			expectFound: false,
+		},
+		{
+			description: "no releases at all",
+			releases:    nil,
+			expectFound: false,
+		},
+	}
+
+	for _, test := range tests {
+		_, tag, found := latestStableRelease(test.releases)
+		if found != test.expectFound {
+			t.Errorf("%s: expected found=%v, got %v", test.description, test.expectFound, found)
+			continue
+		}
+		if found && tag != test.expectedTag {
+			t.Errorf("%s: expected tag %s, got %s", test.description, test.expectedTag, tag)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownHeading = regexp.MustCompile(`(?m)^(#{1,6})\s+(.*)$`)
+	markdownBold    = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	markdownItalic  = regexp.MustCompile(`\*(.+?)\*`)
+	markdownBullet  = regexp.MustCompile(`(?m)^[-*]\s+`)
+)
+
+// RenderMarkdownToANSI renders a (small) subset of markdown - headings, bold,
+// italic and bullet lists - to ANSI escape sequences suitable for direct
+// printing to a terminal. It is intentionally not a general-purpose markdown
+// renderer: it exists only to make GitHub release notes readable in a
+// terminal without pulling in a full markdown/HTML dependency.
+func RenderMarkdownToANSI(markdown string) string {
+	rendered := markdownHeading.ReplaceAllString(markdown, "\033[1;36m$2\033[0m")
+	rendered = markdownBold.ReplaceAllString(rendered, "\033[1m$1\033[0m")
+	rendered = markdownItalic.ReplaceAllString(rendered, "\033[3m$1\033[0m")
+	rendered = markdownBullet.ReplaceAllStringFunc(rendered, func(string) string {
+		return "  • "
+	})
+	return strings.TrimRight(rendered, "\n") + "\n"
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (C) 2016 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import "testing"
+
+func TestRenderMarkdownToANSI(t *testing.T) {
+	var tests = []struct {
+		description string
+		markdown    string
+		expected    string
+	}{
+		{
+			description: "heading",
+			markdown:    "# Release 3.11.0",
+			expected:    "\033[1;36mRelease 3.11.0\033[0m\n",
+		},
+		{
+			description: "bold",
+			markdown:    "**important**",
+			expected:    "\033[1mimportant\033[0m\n",
+		},
+		{
+			description: "bullet list",
+			markdown:    "- first\n- second",
+			expected:    "  • first\n  • second\n",
+		},
+	}
+
+	for _, test := range tests {
+		rendered := RenderMarkdownToANSI(test.markdown)
+		if rendered != test.expected {
+			t.Errorf("%s: RenderMarkdownToANSI(%q) = %q, expected %q", test.description, test.markdown, rendered, test.expected)
+		}
+	}
+}